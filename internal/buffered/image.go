@@ -18,6 +18,9 @@ import (
 	"fmt"
 	"image"
 	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
 
 	"github.com/hajimehoshi/ebiten/internal/affine"
 	"github.com/hajimehoshi/ebiten/internal/driver"
@@ -189,6 +192,74 @@ func (img *Image) Convert2RGBA() *image.RGBA{
 	}
 }
 
+// resolvedRGBA returns an *image.RGBA backed by the resolved pixel buffer,
+// resolving and caching it from the GPU first if necessary. Unlike
+// Convert2RGBA, the returned image always has correct, up-to-date pixels
+// regardless of whether they were already cached. If a fill is pending, it
+// instead returns a newly allocated image filled with the fill color, and
+// that buffer is not cached.
+func (img *Image) resolvedRGBA() (*image.RGBA, error) {
+	if img.hasFill {
+		return img.Convert2RGBA(), nil
+	}
+	if img.pixels == nil {
+		pix, err := img.img.Pixels(0, 0, img.width, img.height)
+		if err != nil {
+			return nil, err
+		}
+		img.pixels = pix
+	}
+	return &image.RGBA{
+		Pix:    img.pixels,
+		Stride: 4 * img.width,
+		Rect:   image.Rect(0, 0, img.width, img.height),
+	}, nil
+}
+
+// SubRGBA returns an *image.RGBA restricted to rect that shares the
+// underlying pixel buffer with the full image, so callers can read out a
+// region without paying for a copy of the whole image. If a fill is pending,
+// the view is over a newly allocated full-size buffer instead (see
+// resolvedRGBA).
+func (img *Image) SubRGBA(rect image.Rectangle) (*image.RGBA, error) {
+	checkDelayedCommandsFlushed("SubRGBA")
+
+	rgba, err := img.resolvedRGBA()
+	if err != nil {
+		return nil, err
+	}
+	return rgba.SubImage(rect).(*image.RGBA), nil
+}
+
+// EncodePNG writes the image to w as a PNG, reusing the resolved pixel
+// buffer directly rather than going through an intermediate copy.
+func (img *Image) EncodePNG(w io.Writer, opts ...func(*png.Encoder)) error {
+	checkDelayedCommandsFlushed("EncodePNG")
+
+	rgba, err := img.resolvedRGBA()
+	if err != nil {
+		return err
+	}
+	enc := &png.Encoder{}
+	for _, opt := range opts {
+		opt(enc)
+	}
+	return enc.Encode(w, rgba)
+}
+
+// EncodeJPEG writes the image to w as a JPEG at the given quality (1-100),
+// reusing the resolved pixel buffer directly rather than going through an
+// intermediate copy.
+func (img *Image) EncodeJPEG(w io.Writer, quality int) error {
+	checkDelayedCommandsFlushed("EncodeJPEG")
+
+	rgba, err := img.resolvedRGBA()
+	if err != nil {
+		return err
+	}
+	return jpeg.Encode(w, rgba, &jpeg.Options{Quality: quality})
+}
+
 func (i *Image) Dump(name string, blackbg bool) error {
 	checkDelayedCommandsFlushed("Dump")
 	return i.img.Dump(name, blackbg)
@@ -305,6 +376,13 @@ func (i *Image) drawImage(src *Image, bounds image.Rectangle, g mipmap.GeoM, col
 	src.resolvePendingPixels(true)
 	i.resolvePendingPixels(false)
 	i.img.DrawImage(src.img, bounds, g, colorm, mode, filter)
+
+	// i.img's pixels just changed on the GPU. i.pixels may still hold a
+	// cached read from an earlier Pixels() call that resolvePendingPixels
+	// above didn't touch (it only clears the cache for pending writes), so
+	// drop it here or later reads through it (e.g. Convert2RGBA, SubRGBA)
+	// would silently return stale, pre-draw data.
+	i.pixels = nil
 }
 
 // DrawTriangles draws the src image with the given vertices.
@@ -360,6 +438,10 @@ func (i *Image) DrawTriangles(src *Image, vertices []float32, indices []uint16,
 		srcImg = src.img
 	}
 	i.img.DrawTriangles(srcImg, vertices, indices, colorm, mode, filter, address, s, us)
+
+	// See the comment in drawImage: i.img's pixels just changed on the GPU,
+	// so any cached read in i.pixels is now stale.
+	i.pixels = nil
 }
 
 type Shader struct {
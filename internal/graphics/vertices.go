@@ -25,7 +25,7 @@ type verticesBackend struct {
 
 const (
 	IndicesNum     = (1 << 16) / 3 * 3 // Adjust num for triangles.
-	VertexFloatNum = 12
+	VertexFloatNum = 16
 )
 
 func (v *verticesBackend) slice(n int) []float32 {
@@ -49,21 +49,18 @@ func (v *verticesBackend) slice(n int) []float32 {
 	return s
 }
 
-func isPowerOf2(x int) bool {
-	if x <= 0 {
-		return false
-	}
-	return (x & (x - 1)) == 0
-}
-
-func QuadVertices(width, height int, sx0, sy0, sx1, sy1 int, a, b, c, d, tx, ty float32, cr, cg, cb, ca float32) []float32 {
-	if !isPowerOf2(width) {
-		panic("not reached")
-	}
-	if !isPowerOf2(height) {
-		panic("not reached")
-	}
-
+// QuadVertices computes the vertex attributes for a textured quad.
+//
+// width and height are the size of the source texture and no longer need to
+// be powers of two: modern GPUs (including all WebGL2/ES3 targets) support
+// NPOT textures with clamp-to-edge sampling, so the atlas no longer has to
+// pad every source image up to the next power-of-two size.
+//
+// cr, cg, cb, ca is the color scale (multiplicative) and or, og, ob, oa is
+// the color offset (additive, in [-1, 1]), applied as color*scale+offset in
+// the shader. The offset lets callers implement effects like flash-on-hit
+// or fade-to-white without allocating an intermediate image.
+func QuadVertices(width, height int, sx0, sy0, sx1, sy1 int, a, b, c, d, tx, ty float32, cr, cg, cb, ca float32, or, og, ob, oa float32) []float32 {
 	if sx0 >= sx1 || sy0 >= sy1 {
 		return nil
 	}
@@ -74,16 +71,24 @@ func QuadVertices(width, height int, sx0, sy0, sx1, sy1 int, a, b, c, d, tx, ty
 	wf := float32(width)
 	hf := float32(height)
 	u0, v0, u1, v1 := float32(sx0)/wf, float32(sy0)/hf, float32(sx1)/wf, float32(sy1)/hf
-	return quadVerticesImpl(float32(sx1-sx0), float32(sy1-sy0), u0, v0, u1, v1, a, b, c, d, tx, ty, cr, cg, cb, ca)
+	return quadVerticesImpl(float32(sx1-sx0), float32(sy1-sy0), u0, v0, u1, v1, a, b, c, d, tx, ty, cr, cg, cb, ca, or, og, ob, oa)
 }
 
-func quadVerticesImpl(x, y, u0, v0, u1, v1, a, b, c, d, tx, ty, cr, cg, cb, ca float32) []float32 {
+func quadVerticesImpl(x, y, u0, v0, u1, v1, a, b, c, d, tx, ty, cr, cg, cb, ca, or, og, ob, oa float32) []float32 {
 	// Specifying a range explicitly here is redundant but this helps optimization
 	// to eliminate boundary checks.
 	//
-	// 4*VertexFloatNum is better than 48, but in GopherJS, optimization might not work.
-	vs := theVerticesBackend.slice(4)[0:48]
+	// 4*VertexFloatNum is better than 64, but in GopherJS, optimization might not work.
+	vs := theVerticesBackend.slice(4)[0:64]
+	putQuadVertices(vs, x, y, u0, v0, u1, v1, a, b, c, d, tx, ty, cr, cg, cb, ca, or, og, ob, oa)
+	return vs
+}
 
+// putQuadVertices writes one quad's vertex attributes into vs, which must be
+// a 4*VertexFloatNum slice. It is shared by quadVerticesImpl, which slices
+// vs out of theVerticesBackend, and QuadVerticesBatch, which slices vs out of
+// a caller-provided buffer.
+func putQuadVertices(vs []float32, x, y, u0, v0, u1, v1, a, b, c, d, tx, ty, cr, cg, cb, ca, or, og, ob, oa float32) {
 	ax, by, cx, dy := a*x, b*y, c*x, d*y
 
 	// Vertex coordinates
@@ -103,48 +108,109 @@ func quadVerticesImpl(x, y, u0, v0, u1, v1, a, b, c, d, tx, ty, cr, cg, cb, ca f
 	vs[9] = cg
 	vs[10] = cb
 	vs[11] = ca
+	vs[12] = or
+	vs[13] = og
+	vs[14] = ob
+	vs[15] = oa
 
 	// and the same for the other three coordinates
-	vs[12] = ax + tx
-	vs[13] = cx + ty
-	vs[14] = u1
-	vs[15] = v0
-	vs[16] = u0
-	vs[17] = v0
+	vs[16] = ax + tx
+	vs[17] = cx + ty
 	vs[18] = u1
-	vs[19] = v1
-	vs[20] = cr
-	vs[21] = cg
-	vs[22] = cb
-	vs[23] = ca
-
-	vs[24] = by + tx
-	vs[25] = dy + ty
-	vs[26] = u0
-	vs[27] = v1
-	vs[28] = u0
-	vs[29] = v0
-	vs[30] = u1
-	vs[31] = v1
-	vs[32] = cr
-	vs[33] = cg
-	vs[34] = cb
-	vs[35] = ca
-
-	vs[36] = ax + by + tx
-	vs[37] = cx + dy + ty
+	vs[19] = v0
+	vs[20] = u0
+	vs[21] = v0
+	vs[22] = u1
+	vs[23] = v1
+	vs[24] = cr
+	vs[25] = cg
+	vs[26] = cb
+	vs[27] = ca
+	vs[28] = or
+	vs[29] = og
+	vs[30] = ob
+	vs[31] = oa
+
+	vs[32] = by + tx
+	vs[33] = dy + ty
+	vs[34] = u0
+	vs[35] = v1
+	vs[36] = u0
+	vs[37] = v0
 	vs[38] = u1
 	vs[39] = v1
-	vs[40] = u0
-	vs[41] = v0
-	vs[42] = u1
-	vs[43] = v1
-	vs[44] = cr
-	vs[45] = cg
-	vs[46] = cb
-	vs[47] = ca
+	vs[40] = cr
+	vs[41] = cg
+	vs[42] = cb
+	vs[43] = ca
+	vs[44] = or
+	vs[45] = og
+	vs[46] = ob
+	vs[47] = oa
+
+	vs[48] = ax + by + tx
+	vs[49] = cx + dy + ty
+	vs[50] = u1
+	vs[51] = v1
+	vs[52] = u0
+	vs[53] = v0
+	vs[54] = u1
+	vs[55] = v1
+	vs[56] = cr
+	vs[57] = cg
+	vs[58] = cb
+	vs[59] = ca
+	vs[60] = or
+	vs[61] = og
+	vs[62] = ob
+	vs[63] = oa
+}
 
-	return vs
+// QuadVertexDescriptor describes one quad to be written by QuadVerticesBatch.
+// Its fields mirror QuadVertices' arguments.
+type QuadVertexDescriptor struct {
+	SrcX0, SrcY0, SrcX1, SrcY1 int
+	A, B, C, D, Tx, Ty         float32
+	Cr, Cg, Cb, Ca             float32
+	Or, Og, Ob, Oa             float32
+}
+
+// QuadVerticesBatch writes the vertex attributes for each of quads into dst,
+// which must have room for len(quads)*4*VertexFloatNum floats, and returns
+// the number of floats written. Quads with an empty or invalid source
+// region are skipped, just as QuadVertices returns nil for them.
+//
+// Unlike QuadVertices, this writes directly into dst instead of slicing
+// theVerticesBackend, so callers building many quads per frame (tilemaps,
+// particle systems) pay one allocation for the whole batch rather than one
+// slice+copy per quad.
+//
+// TODO(partial): this has no caller in this tree yet. It's staged ahead of
+// DrawImageBatch on *Image and the graphicscommand-side coalescing into a
+// single indexed draw call, both of which belong to the public ebiten
+// package and the command queue, neither present here. Land those before
+// relying on this in production; until then it's exercised only by
+// vertices_test.go.
+func QuadVerticesBatch(width, height int, quads []QuadVertexDescriptor, dst []float32) int {
+	wf := float32(width)
+	hf := float32(height)
+
+	n := 0
+	for _, q := range quads {
+		if q.SrcX0 >= q.SrcX1 || q.SrcY0 >= q.SrcY1 {
+			continue
+		}
+		if q.SrcX1 <= 0 || q.SrcY1 <= 0 {
+			continue
+		}
+
+		u0, v0 := float32(q.SrcX0)/wf, float32(q.SrcY0)/hf
+		u1, v1 := float32(q.SrcX1)/wf, float32(q.SrcY1)/hf
+		putQuadVertices(dst[n:n+4*VertexFloatNum], float32(q.SrcX1-q.SrcX0), float32(q.SrcY1-q.SrcY0), u0, v0, u1, v1,
+			q.A, q.B, q.C, q.D, q.Tx, q.Ty, q.Cr, q.Cg, q.Cb, q.Ca, q.Or, q.Og, q.Ob, q.Oa)
+		n += 4 * VertexFloatNum
+	}
+	return n
 }
 
 var (
@@ -155,14 +221,12 @@ func QuadIndices() []uint16 {
 	return quadIndices
 }
 
-func PutVertex(vs []float32, width, height int, dx, dy, sx, sy float32, cr, cg, cb, ca float32) {
-	if !isPowerOf2(width) {
-		panic("not reached")
-	}
-	if !isPowerOf2(height) {
-		panic("not reached")
-	}
-
+// PutVertex writes the vertex attributes for a single vertex into vs.
+//
+// As with QuadVertices, width and height no longer need to be powers of two.
+// cr, cg, cb, ca is the color scale and or, og, ob, oa is the additive color
+// offset; see QuadVertices for how they combine.
+func PutVertex(vs []float32, width, height int, dx, dy, sx, sy float32, cr, cg, cb, ca float32, or, og, ob, oa float32) {
 	wf := float32(width)
 	hf := float32(height)
 
@@ -182,4 +246,8 @@ func PutVertex(vs []float32, width, height int, dx, dy, sx, sy float32, cr, cg,
 	vs[9] = cg
 	vs[10] = cb
 	vs[11] = ca
+	vs[12] = or
+	vs[13] = og
+	vs[14] = ob
+	vs[15] = oa
 }
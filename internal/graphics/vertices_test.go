@@ -0,0 +1,74 @@
+// Copyright 2017 The Ebiten Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphics
+
+import "testing"
+
+func TestPutVertexColorOffset(t *testing.T) {
+	vs := make([]float32, VertexFloatNum)
+	PutVertex(vs, 16, 32, 1, 2, 3, 4, 0.1, 0.2, 0.3, 0.4, -0.5, 0.25, -0.75, 1)
+
+	got := vs[12:16]
+	want := []float32{-0.5, 0.25, -0.75, 1}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("vs[%d] = %v, want %v", 12+i, got[i], want[i])
+		}
+	}
+}
+
+func TestQuadVerticesBatchMatchesQuadVertices(t *testing.T) {
+	type testCase struct {
+		width, height      int
+		sx0, sy0, sx1, sy1 int
+		a, b, c, d, tx, ty float32
+		cr, cg, cb, ca     float32
+		or, og, ob, oa     float32
+	}
+	cases := []testCase{
+		{100, 200, 0, 0, 16, 16, 1, 0, 0, 1, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0},
+		{100, 200, 4, 8, 20, 24, 2, 0.5, -0.5, 2, 10, -10, 0.5, 0.6, 0.7, 0.8, 0.1, -0.1, 0.2, -0.2},
+		{100, 200, 0, 0, 0, 0, 1, 0, 0, 1, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0}, // degenerate: should be skipped
+		{100, 200, 50, 50, 80, 90, -1, 1, 1, -1, 5, 5, 0, 0, 0, 0, 0.3, 0.3, 0.3, 0.3},
+	}
+
+	var want []float32
+	for _, c := range cases {
+		vs := QuadVertices(c.width, c.height, c.sx0, c.sy0, c.sx1, c.sy1, c.a, c.b, c.c, c.d, c.tx, c.ty, c.cr, c.cg, c.cb, c.ca, c.or, c.og, c.ob, c.oa)
+		want = append(want, vs...)
+	}
+
+	quads := make([]QuadVertexDescriptor, len(cases))
+	for i, c := range cases {
+		quads[i] = QuadVertexDescriptor{
+			SrcX0: c.sx0, SrcY0: c.sy0, SrcX1: c.sx1, SrcY1: c.sy1,
+			A: c.a, B: c.b, C: c.c, D: c.d, Tx: c.tx, Ty: c.ty,
+			Cr: c.cr, Cg: c.cg, Cb: c.cb, Ca: c.ca,
+			Or: c.or, Og: c.og, Ob: c.ob, Oa: c.oa,
+		}
+	}
+	dst := make([]float32, len(cases)*4*VertexFloatNum)
+	n := QuadVerticesBatch(100, 200, quads, dst)
+	got := dst[:n]
+
+	if len(got) != len(want) {
+		t.Fatalf("len(got) = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("index %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
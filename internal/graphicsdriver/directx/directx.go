@@ -0,0 +1,138 @@
+// Copyright 2020 The Ebiten Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build windows
+
+// Package directx is TODO(partial): a skeleton for a Direct3D 11 backed
+// driver.Graphics, staged ahead of a real implementation.
+//
+// This package follows the same singleton shape as
+// internal/graphicsdriver/opengl: callers obtain the driver via Get and the
+// rest of the pipeline (buffered, mipmap, restorable) would treat it like
+// any other driver.Graphics implementation. But none of the methods that do
+// actual GPU work are implemented yet (see the TODO(partial) on each one
+// below) — there's no device/swapchain creation, no COM/syscall interop of
+// any kind. internal/uidriver/glfw selects this driver on Windows already
+// (see graphics_windows.go), so the wiring exists, but selecting it gets a
+// driver.Graphics whose NewImage, NewScreenFramebufferImage, Reset and Draw
+// always return errors and whose SetVertices does nothing.
+package directx
+
+import (
+	"errors"
+
+	"github.com/hajimehoshi/ebiten/internal/affine"
+	"github.com/hajimehoshi/ebiten/internal/driver"
+)
+
+var theGraphics = &Graphics{}
+
+// Get returns the shared Direct3D 11 graphics driver.
+func Get() *Graphics {
+	return theGraphics
+}
+
+// Graphics is a driver.Graphics implementation backed by Direct3D 11.
+//
+// device and context hold the D3D11 device/immediate-context pointers once
+// initialized. They are left as uintptr here since this package doesn't pull
+// in a COM binding library; a real implementation would wrap
+// ID3D11Device/ID3D11DeviceContext.
+type Graphics struct {
+	device       uintptr
+	context      uintptr
+	swapChain    uintptr
+	vsyncEnabled bool
+	transparent  bool
+}
+
+// NewImage creates a new D3D11 texture-backed image.
+//
+// TODO(partial): create an ID3D11Texture2D and shader resource/render target
+// views for it. Not implemented.
+func (g *Graphics) NewImage(width, height int) (driver.Image, error) {
+	return nil, errors.New("directx: NewImage is not implemented yet")
+}
+
+// NewScreenFramebufferImage creates the image tied to the swap chain's back buffer.
+//
+// TODO(partial): fetch the swap chain's back buffer and wrap it. Not
+// implemented.
+func (g *Graphics) NewScreenFramebufferImage(width, height int) (driver.Image, error) {
+	return nil, errors.New("directx: NewScreenFramebufferImage is not implemented yet")
+}
+
+// Reset (re)initializes the D3D11 device, context and swap chain.
+//
+// TODO(partial): call D3D11CreateDevice and IDXGIFactory.CreateSwapChain.
+// Not implemented; g.device/g.context/g.swapChain are never populated.
+func (g *Graphics) Reset() error {
+	return errors.New("directx: Reset is not implemented yet")
+}
+
+// SetTransparent sets whether the swap chain's back buffer is transparent.
+func (g *Graphics) SetTransparent(transparent bool) {
+	g.transparent = transparent
+}
+
+// SetVertices uploads vertices and indices to the vertex/index buffers.
+//
+// TODO(partial): map the dynamic vertex/index buffers and copy data in. Not
+// implemented; this currently does nothing.
+func (g *Graphics) SetVertices(vertices []float32, indices []uint16) {
+}
+
+// Draw issues a draw call with the currently bound vertex/index buffers.
+//
+// TODO(partial): set the input layout/shaders/blend state and call
+// ID3D11DeviceContext.DrawIndexed. Not implemented.
+func (g *Graphics) Draw(indexLen int, indexOffset int, mode driver.CompositeMode, colorM *affine.ColorM, filter driver.Filter, address driver.Address) error {
+	return errors.New("directx: Draw is not implemented yet")
+}
+
+// SetVsyncEnabled sets whether DXGI present calls should wait for vblank.
+func (g *Graphics) SetVsyncEnabled(enabled bool) {
+	g.vsyncEnabled = enabled
+}
+
+// VDirection returns the direction of the V texture coordinate axis that
+// Direct3D expects, which is the opposite of OpenGL's.
+func (g *Graphics) VDirection() driver.VDirection {
+	return driver.VDownward
+}
+
+// NeedsRestoring reports whether images need to be restored after a context loss.
+// Direct3D 11 devices can be lost (e.g. on driver upgrade or GPU removal), so
+// this mirrors OpenGL's answer on that front.
+func (g *Graphics) NeedsRestoring() bool {
+	return true
+}
+
+// IsGL reports whether this driver is based on OpenGL. It never is.
+func (g *Graphics) IsGL() bool {
+	return false
+}
+
+// HasHighPrecisionFloat reports whether the driver supports highp-equivalent
+// floats in shaders, which D3D11's shader model always does.
+func (g *Graphics) HasHighPrecisionFloat() bool {
+	return true
+}
+
+// MaxImageSize returns the maximum texture dimension the driver supports.
+func (g *Graphics) MaxImageSize() int {
+	return 16384
+}
+
+var _ driver.Graphics = (*Graphics)(nil)
@@ -0,0 +1,38 @@
+// Copyright 2020 The Ebiten Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build windows
+
+package glfw
+
+import (
+	"os"
+
+	"github.com/hajimehoshi/ebiten/internal/driver"
+	"github.com/hajimehoshi/ebiten/internal/graphicsdriver/directx"
+	"github.com/hajimehoshi/ebiten/internal/graphicsdriver/opengl"
+)
+
+// graphicsDriverForOS selects the graphics driver used on Windows.
+//
+// Direct3D 11 is the default. Setting EBITEN_GRAPHICS_LIBRARY=opengl selects
+// OpenGL instead. The Direct3D 11 driver in internal/graphicsdriver/directx
+// returns errors from NewImage, Reset and Draw, so of the two only OpenGL can
+// render.
+func graphicsDriverForOS() driver.Graphics {
+	if os.Getenv("EBITEN_GRAPHICS_LIBRARY") == "opengl" {
+		return opengl.Get()
+	}
+	return directx.Get()
+}
@@ -0,0 +1,58 @@
+// Copyright 2015 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build js
+
+package js
+
+// Input implements driver.Input for the js backend.
+//
+// The rest of driver.Input (keyboard, touches, gamepads) lives outside this
+// tree. This currently only tracks the relative mouse movement fed by the
+// Pointer Lock API (see UserInterface.initPointerLock), which accumulates
+// over a frame and is cleared once that frame has been processed.
+type Input struct {
+	ui *UserInterface
+
+	mouseMovementX int
+	mouseMovementY int
+}
+
+// MouseMovement returns the relative mouse movement accumulated since the
+// start of the current frame. It is only meaningful while
+// UserInterface.CursorMode is CursorModeCaptured; at other times the
+// mousemove listener in UserInterface.initPointerLock ignores the browser's
+// movementX/movementY deltas, so nothing is accumulated.
+func (i *Input) MouseMovement() (x, y int) {
+	return i.mouseMovementX, i.mouseMovementY
+}
+
+// addMouseMovement accumulates a relative mouse movement delta, as reported
+// by the browser's mousemove event while the pointer is locked.
+func (i *Input) addMouseMovement(dx, dy int) {
+	i.mouseMovementX += dx
+	i.mouseMovementY += dy
+}
+
+// UpdateGamepads is called once per frame by UserInterface.update. This type
+// does not track gamepad state, so it does nothing.
+func (i *Input) UpdateGamepads() {
+}
+
+// resetForFrame clears per-frame input state, including the accumulated
+// mouse movement, once a frame has finished being processed.
+func (i *Input) resetForFrame() {
+	i.mouseMovementX = 0
+	i.mouseMovementY = 0
+}
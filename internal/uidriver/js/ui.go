@@ -36,10 +36,15 @@ type UserInterface struct {
 	sizeChanged bool
 	contextLost bool
 
+	fullscreen bool
+
+	cursorMode driver.CursorMode
+
 	lastDeviceScaleFactor float64
 
 	context driver.UIContext
 	input   Input
+	window  Window
 }
 
 var theUI = &UserInterface{
@@ -49,6 +54,7 @@ var theUI = &UserInterface{
 
 func init() {
 	theUI.input.ui = theUI
+	theUI.window.ui = theUI
 }
 
 func Get() *UserInterface {
@@ -67,14 +73,111 @@ func (u *UserInterface) ScreenSizeInFullscreen() (int, int) {
 	return window.Get("innerWidth").Int(), window.Get("innerHeight").Int()
 }
 
+// SetFullscreen requests (or exits) native browser fullscreen for the
+// canvas. Browsers only grant requestFullscreen from within a user gesture,
+// so if this is called outside one, the desired state is buffered and
+// applied on the next mousedown/keydown/touchstart instead of being
+// silently dropped.
 func (u *UserInterface) SetFullscreen(fullscreen bool) {
-	// Do nothing
+	if u.fullscreen == fullscreen {
+		return
+	}
+	u.fullscreen = fullscreen
+
+	if !u.applyFullscreen() {
+		log.Print("js: fullscreen is not supported by this browser")
+	}
 }
 
 func (u *UserInterface) IsFullscreen() bool {
+	return fullscreenElement().Truthy() && fullscreenElement().Equal(canvas)
+}
+
+// applyFullscreen tries to immediately reconcile the browser's fullscreen
+// state with u.fullscreen. It returns false only if the browser implements
+// none of the fullscreen request/exit functions. A request the browser
+// rejects (on most browsers, one made outside a user gesture) fails
+// asynchronously; the fullscreenerror listener in initFullscreen logs it, and
+// since u.fullscreen keeps the desired state, the next gesture retries it.
+func (u *UserInterface) applyFullscreen() bool {
+	if u.fullscreen == u.IsFullscreen() {
+		return true
+	}
+
+	if u.fullscreen {
+		for _, name := range []string{"requestFullscreen", "webkitRequestFullscreen", "msRequestFullscreen"} {
+			if fn := canvas.Get(name); fn.Truthy() {
+				canvas.Call(name)
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, name := range []string{"exitFullscreen", "webkitExitFullscreen", "msExitFullscreen"} {
+		if fn := document.Get(name); fn.Truthy() {
+			document.Call(name)
+			return true
+		}
+	}
 	return false
 }
 
+// fullscreenElement returns whichever of document.fullscreenElement and its
+// vendor-prefixed equivalents the current browser implements.
+func fullscreenElement() js.Value {
+	for _, name := range []string{"fullscreenElement", "webkitFullscreenElement", "msFullscreenElement"} {
+		if v := document.Get(name); v.Truthy() {
+			return v
+		}
+	}
+	return js.Null()
+}
+
+// initFullscreen subscribes to fullscreenchange so IsFullscreen and the
+// screen size stay correct when the user exits fullscreen directly (e.g.
+// via Esc), logs a warning when the browser rejects a fullscreen request, and
+// retries a buffered SetFullscreen call on the next user gesture if the
+// browser rejected it earlier for not being inside one.
+func (u *UserInterface) initFullscreen() {
+	onFullscreenChange := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		// The browser can leave fullscreen on its own (e.g. the user presses
+		// Esc), so resync u.fullscreen here rather than only updating it from
+		// SetFullscreen. Otherwise a later SetFullscreen(true) call would hit
+		// the no-op fast path and never re-request fullscreen.
+		u.fullscreen = u.IsFullscreen()
+		if u.fullscreen {
+			s := window.Get("devicePixelRatio").Float()
+			screen := window.Get("screen")
+			canvas.Set("width", int(screen.Get("width").Float()*s))
+			canvas.Set("height", int(screen.Get("height").Float()*s))
+		} else {
+			u.updateScreenSize()
+		}
+		u.sizeChanged = true
+		return nil
+	})
+	for _, name := range []string{"fullscreenchange", "webkitfullscreenchange", "MSFullscreenChange"} {
+		document.Call("addEventListener", name, onFullscreenChange)
+	}
+
+	onFullscreenError := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		log.Print("js: fullscreen request was rejected (it must come from a user gesture); will retry on the next interaction")
+		return nil
+	})
+	for _, name := range []string{"fullscreenerror", "webkitfullscreenerror", "MSFullscreenError"} {
+		document.Call("addEventListener", name, onFullscreenError)
+	}
+
+	onGesture := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		u.applyFullscreen()
+		return nil
+	})
+	for _, name := range []string{"mousedown", "keydown", "touchstart"} {
+		canvas.Call("addEventListener", name, onGesture)
+	}
+}
+
 func (u *UserInterface) IsFocused() bool {
 	return u.isFocused()
 }
@@ -95,31 +198,65 @@ func (u *UserInterface) IsVsyncEnabled() bool {
 	return u.vsync
 }
 
+// CursorMode returns the current cursor mode. This is tracked explicitly
+// rather than inferred from the canvas' CSS cursor style, since Pointer Lock
+// (Captured) and the CSS style (Hidden) are independent browser states.
 func (u *UserInterface) CursorMode() driver.CursorMode {
-	if canvas.Get("style").Get("cursor").String() != "none" {
-		return driver.CursorModeVisible
-	}
-	return driver.CursorModeHidden
+	return u.cursorMode
 }
 
 func (u *UserInterface) SetCursorMode(mode driver.CursorMode) {
-	var visible bool
-	switch mode {
-	case driver.CursorModeVisible:
-		visible = true
-	case driver.CursorModeHidden:
-		visible = false
-	default:
+	if u.cursorMode == mode {
 		return
 	}
+	prev := u.cursorMode
+	u.cursorMode = mode
+
+	if prev == driver.CursorModeCaptured && mode != driver.CursorModeCaptured {
+		document.Call("exitPointerLock")
+	}
 
-	if visible {
+	switch mode {
+	case driver.CursorModeVisible:
 		canvas.Get("style").Set("cursor", "auto")
-	} else {
+	case driver.CursorModeHidden:
 		canvas.Get("style").Set("cursor", "none")
+	case driver.CursorModeCaptured:
+		canvas.Call("requestPointerLock")
 	}
 }
 
+// initPointerLock wires up the Pointer Lock API so that CursorMode can
+// report CursorModeCaptured truthfully and so relative mouse movement is
+// available while the pointer is locked.
+func (u *UserInterface) initPointerLock() {
+	document.Call("addEventListener", "pointerlockchange", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if document.Get("pointerLockElement").Equal(canvas) {
+			u.cursorMode = driver.CursorModeCaptured
+		} else if u.cursorMode == driver.CursorModeCaptured {
+			u.cursorMode = driver.CursorModeVisible
+			canvas.Get("style").Set("cursor", "auto")
+		}
+		return nil
+	}))
+	document.Call("addEventListener", "pointerlockerror", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		log.Print("js: pointer lock request failed")
+		if u.cursorMode == driver.CursorModeCaptured {
+			u.cursorMode = driver.CursorModeVisible
+			canvas.Get("style").Set("cursor", "auto")
+		}
+		return nil
+	}))
+	canvas.Call("addEventListener", "mousemove", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if u.cursorMode != driver.CursorModeCaptured {
+			return nil
+		}
+		e := args[0]
+		u.input.addMouseMovement(e.Get("movementX").Int(), e.Get("movementY").Int())
+		return nil
+	}))
+}
+
 func (u *UserInterface) DeviceScaleFactor() float64 {
 	return devicescale.GetAt(0, 0)
 }
@@ -254,6 +391,8 @@ func (u *UserInterface) loop(context driver.UIContext) <-chan error {
 
 func (u *UserInterface) Run(context driver.UIContext) error {
 	canvas.Call("focus")
+	u.initPointerLock()
+	u.initFullscreen()
 	u.running = true
 	ch := u.loop(context)
 	if runtime.GOARCH == "wasm" {
@@ -314,7 +453,7 @@ func (u *UserInterface) Input() driver.Input {
 }
 
 func (u *UserInterface) Window() driver.Window {
-	return nil
+	return &u.window
 }
 
 func (*UserInterface) Graphics() driver.Graphics {
@@ -0,0 +1,147 @@
+// Copyright 2020 The Ebiten Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build js
+
+package js
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/png"
+	"strconv"
+	"syscall/js"
+
+	"github.com/hajimehoshi/ebiten/internal/driver"
+)
+
+// Window implements driver.Window on top of the browser's window/canvas
+// objects. There's no native OS window on the web, so the methods below map
+// each concept to its closest browser equivalent.
+type Window struct {
+	ui *UserInterface
+
+	resizable      bool
+	resizeObserver js.Value
+}
+
+func (w *Window) SetTitle(title string) {
+	document.Set("title", title)
+}
+
+// SetIcon sets the favicon of the page to a data URL rendered from the
+// largest of the given images.
+func (w *Window) SetIcon(iconImages []image.Image) {
+	if len(iconImages) == 0 {
+		return
+	}
+
+	img := iconImages[0]
+	for _, i := range iconImages[1:] {
+		b := i.Bounds()
+		if ib := img.Bounds(); b.Dx()*b.Dy() > ib.Dx()*ib.Dy() {
+			img = i
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return
+	}
+	dataURL := "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes())
+
+	links := document.Call("querySelectorAll", "link[rel=icon]")
+	for i := 0; i < links.Length(); i++ {
+		links.Index(i).Call("remove")
+	}
+
+	link := document.Call("createElement", "link")
+	link.Set("rel", "icon")
+	link.Set("href", dataURL)
+	document.Get("head").Call("appendChild", link)
+}
+
+// SetSize resizes the canvas to width x height CSS pixels.
+//
+// UserInterface.updateSize derives the size it passes to Layout from the
+// body element's clientWidth/clientHeight every frame, and updateScreenSize
+// resizes the canvas from that same box on every device-scale-factor change.
+// So besides resizing the canvas itself, SetSize also resizes the body
+// element to match; otherwise Layout would never observe the new size, and
+// the next device-scale-factor change would silently stomp it back to
+// whatever the body's box happened to be.
+func (w *Window) SetSize(width, height int) {
+	s := w.ui.DeviceScaleFactor()
+	canvas.Set("width", int(float64(width)*s))
+	canvas.Set("height", int(float64(height)*s))
+
+	bodyStyle := document.Get("body").Get("style")
+	bodyStyle.Set("width", strconv.Itoa(width)+"px")
+	bodyStyle.Set("height", strconv.Itoa(height)+"px")
+
+	w.ui.sizeChanged = true
+}
+
+func (w *Window) Size() (int, int) {
+	s := w.ui.DeviceScaleFactor()
+	width := int(float64(canvas.Get("width").Int()) / s)
+	height := int(float64(canvas.Get("height").Int()) / s)
+	return width, height
+}
+
+func (w *Window) SetPosition(x, y int) {
+	// There is no way to move the page's canvas within the browser viewport.
+}
+
+func (w *Window) Position() (int, int) {
+	rect := canvas.Call("getBoundingClientRect")
+	return rect.Get("left").Int(), rect.Get("top").Int()
+}
+
+// SetResizable toggles whether the canvas tracks the containing element's
+// size via a ResizeObserver, or keeps a fixed CSS size instead.
+func (w *Window) SetResizable(resizable bool) {
+	if w.resizable == resizable {
+		return
+	}
+	w.resizable = resizable
+
+	if resizable {
+		if w.resizeObserver.IsUndefined() {
+			w.resizeObserver = js.Global().Get("ResizeObserver").New(js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+				w.ui.updateScreenSize()
+				return nil
+			}))
+		}
+		w.resizeObserver.Call("observe", canvas)
+		canvas.Get("style").Set("width", "")
+		canvas.Get("style").Set("height", "")
+		return
+	}
+
+	if !w.resizeObserver.IsUndefined() {
+		w.resizeObserver.Call("unobserve", canvas)
+	}
+	width, height := w.Size()
+	style := canvas.Get("style")
+	style.Set("width", strconv.Itoa(width)+"px")
+	style.Set("height", strconv.Itoa(height)+"px")
+}
+
+func (w *Window) IsResizable() bool {
+	return w.resizable
+}
+
+var _ driver.Window = (*Window)(nil)